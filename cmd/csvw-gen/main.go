@@ -0,0 +1,62 @@
+// csvw-gen fetches ONS dataset metadata and prints the equivalent CSVW
+// metadata document as JSON.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+
+	"github.com/ONSdigital/dp-dataset-api/models"
+	"github.com/eldeal/metadata-csvw-jsonld/csvw"
+)
+
+func main() {
+	url := "https://api.beta.ons.gov.uk/v1/datasets/ashe-table-7-hours/editions/time-series/versions/1/metadata"
+
+	metadata := getMetadata(url)
+
+	gen := csvw.NewGenerator()
+
+	doc, err := gen.FromMetadata(metadata, metadata.Downloads.CSV.HRef)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(string(b))
+}
+
+func getMetadata(url string) *models.Metadata {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		log.Fatal("NewRequest: ", err)
+		return nil
+	}
+
+	client := &http.Client{}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		log.Fatal("Do: ", err)
+		return nil
+	}
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil
+	}
+	defer resp.Body.Close()
+
+	var md models.Metadata
+	if err := json.Unmarshal(b, &md); err != nil {
+		log.Println(err)
+	}
+
+	return &md
+}
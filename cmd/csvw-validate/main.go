@@ -0,0 +1,38 @@
+// csvw-validate reads a CSVW metadata document as JSON from stdin and
+// checks it for conformance with the parts of the W3C CSVW metadata
+// vocabulary the csvw package relies on, exiting non-zero if it's invalid.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"github.com/eldeal/metadata-csvw-jsonld/csvw"
+)
+
+func main() {
+	b, err := ioutil.ReadAll(os.Stdin)
+	if err != nil {
+		log.Fatal("reading stdin: ", err)
+	}
+
+	var doc csvw.CSVW
+	if err := json.Unmarshal(b, &doc); err != nil {
+		log.Fatal("parsing CSVW document: ", err)
+	}
+
+	errs := csvw.Validate(&doc)
+	if len(errs) == 0 {
+		fmt.Println("valid")
+		return
+	}
+
+	for _, e := range errs {
+		fmt.Fprintln(os.Stderr, e)
+	}
+
+	os.Exit(1)
+}
@@ -0,0 +1,157 @@
+package csvw
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ONSdigital/dp-dataset-api/models"
+)
+
+// defaultDimensionResolver matches a dimension header to a models.CodeList
+// by name, case-insensitively.
+type defaultDimensionResolver struct{}
+
+func (defaultDimensionResolver) Resolve(name string, dims []models.CodeList) (models.CodeList, bool) {
+	for _, d := range dims {
+		if strings.EqualFold(d.Name, name) {
+			return d, true
+		}
+	}
+
+	return models.CodeList{}, false
+}
+
+func (g *Generator) populateColumns(mode Mode, header []string, dims []models.CodeList, unit, csvURL string) ([]Column, []ForeignKey, error) {
+	var list []Column
+	var fks []ForeignKey
+
+	parts := strings.SplitN(header[0], "_", 2)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "V4") {
+		return nil, nil, fmt.Errorf("header %q is not a valid V4_N marker", header[0])
+	}
+
+	offset, err := strconv.Atoi(parts[1])
+	if err != nil || offset < 0 {
+		return nil, nil, fmt.Errorf("header %q is not a valid V4_N marker", header[0])
+	}
+
+	if offset+1 > len(header) {
+		return nil, nil, fmt.Errorf("header %q claims %d data marking columns but only %d columns were found", header[0], offset, len(header)-1)
+	}
+
+	//observations
+	col := Column{
+		"titles":   header[0],
+		"name":     unit,
+		"datatype": "number",
+		"required": true,
+		"@id":      csvURL + "#col=0",
+	}
+
+	list = append(list, col)
+
+	//data markings
+	if offset != 0 {
+		for i := 1; i <= offset; i++ {
+			col := Column{
+				"titles": header[i],
+				"@id":    csvURL + "#col=" + strconv.Itoa(i),
+			}
+			list = append(list, col)
+		}
+	}
+
+	offset += 1
+
+	header = header[offset:]
+
+	//dimensions
+	for i := 0; i < len(header); i = i + 2 {
+		if i+1 >= len(header) {
+			return nil, nil, fmt.Errorf("header %q has no matching dimension column", header[i])
+		}
+
+		codeHeader := header[i]
+		dimHeader := header[i+1]
+		dimHeader = strings.ToLower(dimHeader)
+
+		if !strings.EqualFold(codeHeader, dimHeader+"_codelist") {
+			return nil, nil, fmt.Errorf("header %q does not pair with %q as <dim>_codelist,<dim>", codeHeader, header[i+1])
+		}
+
+		dim, ok := g.DimensionResolver.Resolve(dimHeader, dims)
+		if !ok {
+			if mode != ModeCustom {
+				return nil, nil, fmt.Errorf("header %q has no matching entry in metadata.Dimensions", header[i+1])
+			}
+
+			// Custom datasets aren't guaranteed to have every dimension
+			// registered up front; fall back to what the header tells us.
+			dim = models.CodeList{Name: dimHeader}
+		}
+
+		codeCol, labelCol := g.dimensionColumns(mode, dim, dimHeader, codeHeader, csvURL, offset+i, offset+i+1)
+
+		list = append(list, codeCol, labelCol)
+
+		if fk, ok := foreignKey(dim, codeHeader); ok {
+			fks = append(fks, fk)
+		}
+	}
+
+	return list, fks, nil
+}
+
+// foreignKey builds the CSVW foreignKeys entry linking a dimension's code
+// column to its code-list resource, when that code list is known.
+func foreignKey(dim models.CodeList, codeHeader string) (ForeignKey, bool) {
+	if dim.HRef == "" {
+		return ForeignKey{}, false
+	}
+
+	return ForeignKey{
+		ColumnReference: codeHeader,
+		Reference: ForeignKeyReference{
+			Resource:        dim.HRef,
+			ColumnReference: "code",
+		},
+	}, true
+}
+
+// dimensionColumns builds the code/label column pair for a single
+// dimension. In ModeCustom, dim's fields aren't guaranteed to be populated,
+// so only the ones that are known are emitted.
+func (g *Generator) dimensionColumns(mode Mode, dim models.CodeList, dimHeader, codeHeader, csvURL string, codeColIdx, labelColIdx int) (Column, Column) {
+	codeCol := Column{
+		"name": codeHeader,
+		"@id":  csvURL + "#col=" + strconv.Itoa(codeColIdx),
+	}
+
+	labelCol := Column{
+		"name": dimHeader,
+		"@id":  csvURL + "#col=" + strconv.Itoa(labelColIdx),
+	}
+
+	if mode == ModeCustom {
+		if dim.HRef != "" {
+			codeCol["valueURL"] = dim.HRef + "/codes/{" + codeHeader + "}"
+		}
+		if dim.Label != "" {
+			labelCol["titles"] = dim.Label
+		}
+		if dim.Description != "" {
+			labelCol["description"] = dim.Description
+		}
+
+		return codeCol, labelCol
+	}
+
+	codeCol["valueURL"] = dim.HRef + "/codes/{" + codeHeader + "}" //how do we link to the code list or API?
+	codeCol["required"] = true
+
+	labelCol["titles"] = dim.Label
+	labelCol["description"] = dim.Description
+
+	return codeCol, labelCol
+}
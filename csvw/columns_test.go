@@ -0,0 +1,90 @@
+package csvw
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ONSdigital/dp-dataset-api/models"
+)
+
+func TestGenerator_populateColumns_ForeignKeys(t *testing.T) {
+	dims := []models.CodeList{
+		{Name: "time", Label: "Time", HRef: "https://example.com/code-lists/time"},
+	}
+
+	g := &Generator{DimensionResolver: &defaultDimensionResolver{}}
+
+	_, fks, err := g.populateColumns(ModePublished, []string{"V4_0", "Time_codelist", "Time"}, dims, "count", "https://example.com/data.csv")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(fks) != 1 {
+		t.Fatalf("got %d foreign keys, want 1", len(fks))
+	}
+
+	want := ForeignKey{
+		ColumnReference: "Time_codelist",
+		Reference: ForeignKeyReference{
+			Resource:        "https://example.com/code-lists/time",
+			ColumnReference: "code",
+		},
+	}
+	if fks[0] != want {
+		t.Errorf("foreign key = %+v, want %+v", fks[0], want)
+	}
+}
+
+func TestGenerator_populateColumns(t *testing.T) {
+	dims := []models.CodeList{
+		{Name: "time", Label: "Time", HRef: "https://example.com/code-lists/time"},
+	}
+
+	tests := map[string]struct {
+		header  []string
+		wantErr string
+	}{
+		"valid header produces observation and dimension columns": {
+			header: []string{"V4_0", "Time_codelist", "Time"},
+		},
+		"missing V4 marker": {
+			header:  []string{"Value", "Time_codelist", "Time"},
+			wantErr: "not a valid V4_N marker",
+		},
+		"code/dimension pair mismatch": {
+			header:  []string{"V4_0", "Geography_codelist", "Time"},
+			wantErr: "does not pair with",
+		},
+		"unknown dimension": {
+			header:  []string{"V4_0", "Region_codelist", "Region"},
+			wantErr: "no matching entry in metadata.Dimensions",
+		},
+		"V4 marker claims more data marking columns than exist": {
+			header:  []string{"V4_5", "onlyone"},
+			wantErr: "claims 5 data marking columns",
+		},
+		"negative V4 marker": {
+			header:  []string{"V4_-1", "Time_codelist", "Time"},
+			wantErr: "not a valid V4_N marker",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := &Generator{DimensionResolver: &defaultDimensionResolver{}}
+
+			_, _, err := g.populateColumns(ModePublished, tc.header, dims, "count", "https://example.com/data.csv")
+
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("error = %v, want containing %q", err, tc.wantErr)
+			}
+		})
+	}
+}
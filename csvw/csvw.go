@@ -0,0 +1,79 @@
+// Package csvw generates W3C CSVW (CSV on the Web) metadata documents
+// describing ONS dataset downloads, so that the same logic can be shared
+// across services instead of being copy-pasted into each one.
+package csvw
+
+import (
+	"encoding/json"
+
+	"github.com/ONSdigital/dp-dataset-api/models"
+)
+
+// CSVW is the root of a generated CSVW metadata document. Callers that
+// need to attach dataset-specific JSON-LD terms CSVW doesn't model
+// directly (e.g. dcat:distribution, prov:wasDerivedFrom) should set
+// Extensions rather than editing this struct.
+type CSVW struct {
+	Context     string                `json:"@context"`
+	URL         string                `json:"url"`
+	Title       string                `json:"dct:title"`
+	Description string                `json:"dct:description"`
+	Issued      string                `json:"dct:issued,omitempty"`
+	Creator     Creator               `json:"dct:publisher"`
+	Contact     models.ContactDetails `json:"dcat:contactPoint"`
+	TableSchema Columns               `json:"tableSchema"`
+	Theme       string                `json:"dcat:theme"`
+	License     string                `json:"dct:license"`
+	Frequency   string                `json:"dct:accrualPeriodicity"`
+	Notes       []Note                `json:"notes"`
+
+	// Extensions holds additional top-level JSON-LD terms to inline
+	// alongside the fields above. Keys that collide with one of CSVW's
+	// own JSON field names are rejected by MarshalJSON.
+	Extensions map[string]json.RawMessage `json:"-"`
+}
+
+// Creator describes the dct:publisher of a dataset.
+type Creator struct {
+	Name string `json:"name"`
+	Type string `json:"@type"`
+	ID   string `json:"@id"` //a URL where more info is available
+}
+
+// Columns is the CSVW tableSchema.
+type Columns struct {
+	C           []Column     `json:"columns"`
+	About       string       `json:"aboutUrl"`
+	ForeignKeys []ForeignKey `json:"foreignKeys,omitempty"`
+}
+
+// Column is a single CSVW tableSchema column description. Being a plain
+// map, it already accepts arbitrary extension keys alongside the ones
+// this package sets.
+type Column map[string]interface{}
+
+// ForeignKey declares that a column's values reference rows in another
+// CSVW table, per https://www.w3.org/TR/tabular-metadata/#foreign-keys.
+// It's how a dimension's code column is linked to the code list that
+// defines its values, instead of just hinting at it via valueURL.
+type ForeignKey struct {
+	ColumnReference string              `json:"columnReference"`
+	Reference       ForeignKeyReference `json:"reference"`
+}
+
+// ForeignKeyReference identifies the table and column a ForeignKey points
+// at. Resource is used when the target is a single CSVW table; set
+// SchemaReference instead when referencing a shared schema.
+type ForeignKeyReference struct {
+	Resource        string `json:"resource,omitempty"`
+	SchemaReference string `json:"schemaReference,omitempty"`
+	ColumnReference string `json:"columnReference"`
+}
+
+// Note is an ONS-specific alert or usage note attached to a dataset.
+type Note struct {
+	Type       string `json:"type"` // is this an enum?
+	Target     string `json:"target"`
+	Body       string `json:"body"`
+	Motivation string `json:"motivation"` // how is this different from type? do we need this? is this an enum?
+}
@@ -0,0 +1,86 @@
+package csvw
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// reservedCSVWFields is the set of JSON field names CSVW's own struct tags
+// use, generated once from CSVW's fields by reflection. Extensions may not
+// use any of these names.
+var reservedCSVWFields = jsonFieldNames(reflect.TypeOf(CSVW{}))
+
+func jsonFieldNames(t reflect.Type) map[string]bool {
+	names := make(map[string]bool, t.NumField())
+
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("json")
+		if tag == "" || tag == "-" {
+			continue
+		}
+
+		if name := strings.Split(tag, ",")[0]; name != "" {
+			names[name] = true
+		}
+	}
+
+	return names
+}
+
+// MarshalJSON inlines c.Extensions alongside CSVW's own fields.
+func (c CSVW) MarshalJSON() ([]byte, error) {
+	type alias CSVW
+
+	b, err := json.Marshal(alias(c))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(c.Extensions) == 0 {
+		return b, nil
+	}
+
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+
+	for k, v := range c.Extensions {
+		if reservedCSVWFields[k] {
+			return nil, fmt.Errorf("csvw: extension key %q collides with a reserved CSVW field", k)
+		}
+		doc[k] = v
+	}
+
+	return json.Marshal(doc)
+}
+
+// UnmarshalJSON populates CSVW's own fields and collects any remaining
+// top-level keys into Extensions.
+func (c *CSVW) UnmarshalJSON(data []byte) error {
+	type alias CSVW
+
+	var a alias
+	if err := json.Unmarshal(data, &a); err != nil {
+		return err
+	}
+	*c = CSVW(a)
+
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+
+	for k := range reservedCSVWFields {
+		delete(doc, k)
+	}
+
+	c.Extensions = nil
+	if len(doc) > 0 {
+		c.Extensions = doc
+	}
+
+	return nil
+}
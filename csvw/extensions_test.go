@@ -0,0 +1,48 @@
+package csvw
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCSVW_Extensions_RoundTrip(t *testing.T) {
+	doc := &CSVW{
+		Context: "http://www.w3.org/ns/csvw",
+		URL:     "https://example.com/data.csv",
+		Title:   "Test dataset",
+		Extensions: map[string]json.RawMessage{
+			"dcat:distribution": json.RawMessage(`{"@id":"https://example.com/dist"}`),
+		},
+	}
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	var got CSVW
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	if got.Title != doc.Title {
+		t.Errorf("Title = %q, want %q", got.Title, doc.Title)
+	}
+
+	if string(got.Extensions["dcat:distribution"]) != string(doc.Extensions["dcat:distribution"]) {
+		t.Errorf("Extensions[dcat:distribution] = %s, want %s", got.Extensions["dcat:distribution"], doc.Extensions["dcat:distribution"])
+	}
+}
+
+func TestCSVW_Extensions_CollisionRejected(t *testing.T) {
+	doc := &CSVW{
+		Context: "http://www.w3.org/ns/csvw",
+		Extensions: map[string]json.RawMessage{
+			"url": json.RawMessage(`"https://example.com/not-allowed"`),
+		},
+	}
+
+	if _, err := json.Marshal(doc); err == nil {
+		t.Fatal("expected an error for a reserved extension key, got nil")
+	}
+}
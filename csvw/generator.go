@@ -0,0 +1,143 @@
+package csvw
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ONSdigital/dp-dataset-api/models"
+)
+
+// HeaderParser extracts the column header row used to derive a CSVW
+// tableSchema from a dataset's CSV download.
+type HeaderParser interface {
+	ParseHeader(csvURL string) ([]string, error)
+}
+
+// DimensionResolver looks up the models.CodeList describing a named
+// dimension.
+type DimensionResolver interface {
+	Resolve(name string, dims []models.CodeList) (models.CodeList, bool)
+}
+
+// Mode controls how Generator handles dataset metadata that doesn't have
+// all of its dimensions and code lists known up front.
+type Mode int
+
+const (
+	// ModePublished is for datasets whose dimensions, code lists and
+	// release metadata are fully known, e.g. anything served from the
+	// dataset API's published collection. This is the default.
+	ModePublished Mode = iota
+
+	// ModeCustom is for custom (e.g. Cantabular) datasets assembled from
+	// a user's query, where fields like dct:issued or a code list's HRef
+	// may not exist yet.
+	ModeCustom
+)
+
+// Generator builds CSVW metadata documents from ONS dataset metadata. The
+// zero value is not usable; construct one with NewGenerator.
+type Generator struct {
+	HeaderParser      HeaderParser
+	DimensionResolver DimensionResolver
+
+	// Mode forces ModeCustom handling regardless of metadata. Leave at
+	// the zero value (ModePublished) to let FromMetadata detect custom
+	// datasets from metadata.IsBasedOn instead.
+	Mode Mode
+}
+
+// effectiveMode works out whether m should be treated as a custom dataset,
+// either because the Generator was configured to always do so or because
+// the metadata itself says it's based on a custom query.
+func (g *Generator) effectiveMode(m *models.Metadata) Mode {
+	if g.Mode == ModeCustom || m.IsBasedOn != nil {
+		return ModeCustom
+	}
+
+	return ModePublished
+}
+
+// NewGenerator returns a Generator configured with the default
+// HeaderParser and DimensionResolver.
+func NewGenerator() *Generator {
+	return &Generator{
+		HeaderParser:      &httpHeaderParser{},
+		DimensionResolver: &defaultDimensionResolver{},
+	}
+}
+
+// FromMetadata builds a CSVW document describing the dataset download at
+// downloadURL, using m to populate the top-level metadata and tableSchema
+// columns.
+func (g *Generator) FromMetadata(m *models.Metadata, downloadURL string) (*CSVW, error) {
+	if m == nil {
+		return nil, errors.New("csvw: metadata is nil")
+	}
+
+	mode := g.effectiveMode(m)
+
+	c := assignTopLevel(m, mode)
+	c.TableSchema.About = downloadURL
+
+	header, err := g.HeaderParser.ParseHeader(downloadURL)
+	if err != nil {
+		return nil, fmt.Errorf("csvw: parsing header for %q: %w", downloadURL, err)
+	}
+
+	cols, fks, err := g.populateColumns(mode, header, m.Dimensions, m.UnitOfMeasure, downloadURL)
+	if err != nil {
+		return nil, fmt.Errorf("csvw: populating columns for %q: %w", downloadURL, err)
+	}
+	c.TableSchema.C = cols
+	c.TableSchema.ForeignKeys = fks
+
+	var alerts []models.Alert
+	if m.Alerts != nil {
+		alerts = *m.Alerts
+	}
+
+	var usage []models.UsageNote
+	if m.UsageNotes != nil {
+		usage = *m.UsageNotes
+	}
+
+	c.Notes = addNotes(downloadURL, alerts, usage)
+
+	return c, nil
+}
+
+func assignTopLevel(m *models.Metadata, mode Mode) *CSVW {
+	c := &CSVW{
+		Context:     "http://www.w3.org/ns/csvw",
+		Title:       m.Title,
+		Description: m.Description,
+		Theme:       m.Theme,
+		License:     m.License,
+		Frequency:   m.ReleaseFrequency,
+	}
+
+	// Custom datasets are generated on demand and don't have a release
+	// date to report.
+	if mode != ModeCustom {
+		c.Issued = m.ReleaseDate
+	}
+
+	if m.Downloads != nil && m.Downloads.CSV != nil {
+		c.URL = m.Downloads.CSV.HRef
+	}
+
+	if len(m.Contacts) > 0 {
+		c.Contact = m.Contacts[0]
+	}
+
+	if m.Publisher != nil {
+		c.Creator = Creator{
+			Name: m.Publisher.Name,
+			Type: m.Publisher.Type,
+			ID:   m.Publisher.HRef,
+		}
+	}
+
+	return c
+}
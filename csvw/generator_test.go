@@ -0,0 +1,141 @@
+package csvw
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ONSdigital/dp-dataset-api/models"
+)
+
+type stubHeaderParser struct {
+	header []string
+	err    error
+}
+
+func (s stubHeaderParser) ParseHeader(csvURL string) ([]string, error) {
+	return s.header, s.err
+}
+
+type stubDimensionResolver struct {
+	dims map[string]models.CodeList
+}
+
+func (s stubDimensionResolver) Resolve(name string, dims []models.CodeList) (models.CodeList, bool) {
+	d, ok := s.dims[name]
+	return d, ok
+}
+
+func TestGenerator_FromMetadata(t *testing.T) {
+	tests := map[string]struct {
+		metadata *models.Metadata
+		header   []string
+		wantErr  bool
+	}{
+		"populates top level fields and columns": {
+			metadata: &models.Metadata{
+				Title:       "Test dataset",
+				Description: "a test dataset",
+				ReleaseDate: "2020-01-01",
+				Contacts:    []models.ContactDetails{{Name: "A Contact"}},
+				Dimensions: []models.CodeList{
+					{Name: "time", Label: "Time", HRef: "https://example.com/code-lists/time"},
+				},
+				UnitOfMeasure: "percentage",
+			},
+			header:  []string{"V4_0", "Time_codelist", "Time"},
+			wantErr: false,
+		},
+		"propagates header parser errors": {
+			metadata: &models.Metadata{},
+			header:   nil,
+			wantErr:  true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := &Generator{
+				HeaderParser: stubHeaderParser{header: tc.header, err: nil},
+				DimensionResolver: stubDimensionResolver{dims: map[string]models.CodeList{
+					"time": {Name: "time", Label: "Time", HRef: "https://example.com/code-lists/time"},
+				}},
+			}
+
+			if tc.wantErr {
+				g.HeaderParser = stubHeaderParser{err: errTest}
+			}
+
+			doc, err := g.FromMetadata(tc.metadata, "https://example.com/data.csv")
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got nil")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if doc.Title != tc.metadata.Title {
+				t.Errorf("Title = %q, want %q", doc.Title, tc.metadata.Title)
+			}
+
+			if len(doc.TableSchema.C) != len(tc.header) {
+				t.Errorf("got %d columns, want %d", len(doc.TableSchema.C), len(tc.header))
+			}
+
+			if _, err := json.Marshal(doc); err != nil {
+				t.Errorf("Marshal: %v", err)
+			}
+		})
+	}
+}
+
+func TestGenerator_FromMetadata_Custom(t *testing.T) {
+	metadata := &models.Metadata{
+		Title:       "Custom dataset",
+		ReleaseDate: "2020-01-01",
+		IsBasedOn:   &models.IsBasedOn{ID: "UR_HH", Type: "cantabular-table"},
+		// No Contacts, Publisher or Dimensions - shouldn't panic.
+		UnitOfMeasure: "count",
+	}
+
+	g := &Generator{
+		HeaderParser:      stubHeaderParser{header: []string{"V4_0", "Time_codelist", "Time"}},
+		DimensionResolver: &defaultDimensionResolver{},
+	}
+
+	doc, err := g.FromMetadata(metadata, "https://example.com/data.csv")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if doc.Issued != "" {
+		t.Errorf("Issued = %q, want empty for a custom dataset", doc.Issued)
+	}
+
+	codeCol := doc.TableSchema.C[1]
+	if _, ok := codeCol["valueURL"]; ok {
+		t.Errorf("expected no valueURL for a dimension with no HRef, got %v", codeCol["valueURL"])
+	}
+
+	labelCol := doc.TableSchema.C[2]
+	if _, ok := labelCol["titles"]; ok {
+		t.Errorf("expected no titles for a dimension with no Label, got %v", labelCol["titles"])
+	}
+}
+
+func TestGenerator_FromMetadata_NilMetadata(t *testing.T) {
+	g := NewGenerator()
+
+	if _, err := g.FromMetadata(nil, "https://example.com/data.csv"); err == nil {
+		t.Fatal("expected an error for nil metadata")
+	}
+}
+
+type testError string
+
+func (e testError) Error() string { return string(e) }
+
+const errTest = testError("boom")
@@ -0,0 +1,34 @@
+package csvw
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+)
+
+// httpHeaderParser is the default HeaderParser. It fetches csvURL and reads
+// only the first row, so that generating CSVW metadata for a large dataset
+// download doesn't require reading the whole file.
+type httpHeaderParser struct{}
+
+func (httpHeaderParser) ParseHeader(csvURL string) ([]string, error) {
+	resp, err := http.Get(csvURL)
+	if err != nil {
+		return nil, fmt.Errorf("fetching %q: %w", csvURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %q: unexpected status %s", csvURL, resp.Status)
+	}
+
+	r := csv.NewReader(resp.Body)
+	r.FieldsPerRecord = -1
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading header row of %q: %w", csvURL, err)
+	}
+
+	return header, nil
+}
@@ -0,0 +1,25 @@
+package csvw
+
+import "github.com/ONSdigital/dp-dataset-api/models"
+
+func addNotes(url string, alerts []models.Alert, notes []models.UsageNote) []Note {
+	var list []Note
+
+	for _, a := range alerts {
+		list = append(list, Note{
+			Type:   a.Type,
+			Body:   a.Description,
+			Target: url,
+		})
+	}
+
+	for _, u := range notes {
+		list = append(list, Note{
+			Type:   u.Title,
+			Body:   u.Note,
+			Target: url + "#col=need-to-store",
+		})
+	}
+
+	return list
+}
@@ -0,0 +1,100 @@
+package csvw
+
+import "fmt"
+
+// ValidationError is a single conformance failure found by Validate. Path
+// is a JSON pointer (RFC 6901) into the document that failed, so tooling
+// can report exactly where the problem is.
+type ValidationError struct {
+	Path    string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// csvwDatatypes are the built-in atomic datatypes defined by the CSVW
+// metadata vocabulary (https://www.w3.org/TR/tabular-metadata/#datatypes).
+// It's not exhaustive of every derived datatype, but covers the ones this
+// package and its callers actually emit.
+var csvwDatatypes = map[string]bool{
+	"string": true, "boolean": true, "decimal": true, "integer": true,
+	"long": true, "int": true, "short": true, "byte": true,
+	"nonNegativeInteger": true, "positiveInteger": true,
+	"unsignedLong": true, "unsignedInt": true, "unsignedShort": true, "unsignedByte": true,
+	"nonPositiveInteger": true, "negativeInteger": true,
+	"double": true, "float": true, "number": true,
+	"date": true, "dateTime": true, "dateTimeStamp": true,
+	"time": true, "duration": true, "dayTimeDuration": true, "yearMonthDuration": true,
+	"anyURI": true, "QName": true, "xml": true, "html": true, "json": true,
+	"base64Binary": true, "hexBinary": true,
+	"gYear": true, "gYearMonth": true, "gMonth": true, "gMonthDay": true, "gDay": true,
+}
+
+// Validate checks c against the parts of the W3C CSVW metadata vocabulary
+// this package relies on: required top-level properties, well-formed
+// tableSchema columns, and foreignKeys that resolve to a declared column.
+// It returns every failure found rather than stopping at the first one.
+func Validate(c *CSVW) []error {
+	if c == nil {
+		return []error{&ValidationError{Path: "", Message: "csvw document is nil"}}
+	}
+
+	var errs []error
+
+	if c.Context != "http://www.w3.org/ns/csvw" {
+		errs = append(errs, &ValidationError{Path: "/@context", Message: fmt.Sprintf("must be %q", "http://www.w3.org/ns/csvw")})
+	}
+
+	if c.URL == "" {
+		errs = append(errs, &ValidationError{Path: "/url", Message: "is required"})
+	}
+
+	names := make(map[string]bool, len(c.TableSchema.C))
+
+	for i, col := range c.TableSchema.C {
+		path := fmt.Sprintf("/tableSchema/columns/%d", i)
+
+		name, _ := col["name"].(string)
+		if name == "" {
+			errs = append(errs, &ValidationError{Path: path + "/name", Message: "is required"})
+		} else if names[name] {
+			errs = append(errs, &ValidationError{Path: path + "/name", Message: fmt.Sprintf("duplicate column name %q", name)})
+		} else {
+			names[name] = true
+		}
+
+		if dt, ok := col["datatype"]; ok {
+			dtStr, isString := dt.(string)
+			if !isString || !csvwDatatypes[dtStr] {
+				errs = append(errs, &ValidationError{Path: path + "/datatype", Message: fmt.Sprintf("%v is not a valid CSVW datatype", dt)})
+			}
+		}
+
+		if req, ok := col["required"]; ok {
+			if _, isBool := req.(bool); !isBool {
+				errs = append(errs, &ValidationError{Path: path + "/required", Message: "must be a boolean"})
+			}
+		}
+	}
+
+	for i, fk := range c.TableSchema.ForeignKeys {
+		path := fmt.Sprintf("/tableSchema/foreignKeys/%d/columnReference", i)
+
+		if fk.ColumnReference == "" {
+			errs = append(errs, &ValidationError{Path: path, Message: "is required"})
+		} else if !names[fk.ColumnReference] {
+			errs = append(errs, &ValidationError{Path: path, Message: fmt.Sprintf("column %q is not declared in tableSchema.columns", fk.ColumnReference)})
+		}
+
+		if fk.Reference.Resource == "" && fk.Reference.SchemaReference == "" {
+			errs = append(errs, &ValidationError{
+				Path:    fmt.Sprintf("/tableSchema/foreignKeys/%d/reference", i),
+				Message: "must set resource or schemaReference",
+			})
+		}
+	}
+
+	return errs
+}
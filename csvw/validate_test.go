@@ -0,0 +1,85 @@
+package csvw
+
+import "testing"
+
+func TestValidate(t *testing.T) {
+	validDoc := func() *CSVW {
+		return &CSVW{
+			Context: "http://www.w3.org/ns/csvw",
+			URL:     "https://example.com/data.csv",
+			TableSchema: Columns{
+				C: []Column{
+					{"name": "value", "datatype": "number", "required": true},
+					{"name": "time_codelist"},
+				},
+				ForeignKeys: []ForeignKey{
+					{
+						ColumnReference: "time_codelist",
+						Reference:       ForeignKeyReference{Resource: "https://example.com/code-lists/time", ColumnReference: "code"},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("valid document has no errors", func(t *testing.T) {
+		if errs := Validate(validDoc()); len(errs) != 0 {
+			t.Fatalf("got %d errors, want 0: %v", len(errs), errs)
+		}
+	})
+
+	t.Run("nil document", func(t *testing.T) {
+		if errs := Validate(nil); len(errs) != 1 {
+			t.Fatalf("got %d errors, want 1: %v", len(errs), errs)
+		}
+	})
+
+	t.Run("wrong context and missing url", func(t *testing.T) {
+		doc := validDoc()
+		doc.Context = "bogus"
+		doc.URL = ""
+
+		errs := Validate(doc)
+		if len(errs) != 2 {
+			t.Fatalf("got %d errors, want 2: %v", len(errs), errs)
+		}
+	})
+
+	t.Run("duplicate column names", func(t *testing.T) {
+		doc := validDoc()
+		doc.TableSchema.C = append(doc.TableSchema.C, Column{"name": "value"})
+
+		errs := Validate(doc)
+		if len(errs) != 1 {
+			t.Fatalf("got %d errors, want 1: %v", len(errs), errs)
+		}
+	})
+
+	t.Run("invalid datatype", func(t *testing.T) {
+		doc := validDoc()
+		doc.TableSchema.C[0]["datatype"] = "not-a-real-type"
+
+		errs := Validate(doc)
+		if len(errs) != 1 {
+			t.Fatalf("got %d errors, want 1: %v", len(errs), errs)
+		}
+	})
+
+	t.Run("foreign key references unknown column", func(t *testing.T) {
+		doc := validDoc()
+		doc.TableSchema.ForeignKeys[0].ColumnReference = "missing"
+
+		errs := Validate(doc)
+		if len(errs) != 1 {
+			t.Fatalf("got %d errors, want 1: %v", len(errs), errs)
+		}
+
+		ve, ok := errs[0].(*ValidationError)
+		if !ok {
+			t.Fatalf("error is %T, want *ValidationError", errs[0])
+		}
+		if ve.Path != "/tableSchema/foreignKeys/0/columnReference" {
+			t.Errorf("Path = %q", ve.Path)
+		}
+	})
+}